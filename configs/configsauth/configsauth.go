@@ -0,0 +1,37 @@
+// Package configsauth holds the JWT signing configuration consumed by
+// pkg/auth. Values are populated from the environment during bootstrap.
+package configsauth
+
+import "time"
+
+// Algorithm identifies which JWT signing method pkg/auth should use.
+type Algorithm string
+
+const (
+	AlgorithmHS256 Algorithm = "HS256"
+	AlgorithmRS256 Algorithm = "RS256"
+)
+
+// Config is the signing configuration for access and refresh tokens.
+type Config struct {
+	Algorithm       Algorithm
+	HMACSecret      []byte
+	RSAPrivateKey   []byte // PEM-encoded, only used when Algorithm is RS256
+	RSAPublicKey    []byte // PEM-encoded, only used when Algorithm is RS256
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	Issuer          string
+}
+
+// current is set by Init during application bootstrap.
+var current Config
+
+// Init stores the JWT configuration for pkg/auth to read via Get.
+func Init(cfg Config) {
+	current = cfg
+}
+
+// Get returns the active JWT configuration.
+func Get() Config {
+	return current
+}
@@ -0,0 +1,20 @@
+// Package configsdatabase owns the single *gorm.DB instance shared by every
+// repository.
+package configsdatabase
+
+import "gorm.io/gorm"
+
+// DB is the process-wide database handle, assigned by Init during bootstrap.
+var DB *gorm.DB
+
+// Init stores the already-opened database handle for repositories to use via
+// GetDB. Connection setup (dialector, pooling, migrations) happens in the
+// application bootstrap code, not here.
+func Init(db *gorm.DB) {
+	DB = db
+}
+
+// GetDB returns the shared database handle.
+func GetDB() *gorm.DB {
+	return DB
+}
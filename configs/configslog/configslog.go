@@ -0,0 +1,21 @@
+// Package configslog holds the process-wide structured logger used by every
+// layer (services, repositories, pkg) instead of threading a logger through
+// each constructor.
+package configslog
+
+import "go.uber.org/zap"
+
+// Log is set by Init and is nil until then; callers must not use it before
+// Init runs.
+var Log *zap.Logger
+
+// Init builds the package-level logger. It should be called once during
+// application bootstrap, before any service or repository is used.
+func Init() error {
+	logger, err := zap.NewProduction()
+	if err != nil {
+		return err
+	}
+	Log = logger
+	return nil
+}
@@ -0,0 +1,23 @@
+// Package configspassword holds the password.Policy applied to every
+// account in the process, populated from the environment during bootstrap.
+package configspassword
+
+import "davet.link/pkg/password"
+
+var current = password.Policy{
+	MinLen:        10,
+	RequireUpper:  true,
+	RequireDigit:  true,
+	RequireSymbol: true,
+	MaxRepeated:   3,
+}
+
+// Init replaces the active policy.
+func Init(policy password.Policy) {
+	current = policy
+}
+
+// Get returns the active policy.
+func Get() password.Policy {
+	return current
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Scopes is a JSON-encoded string slice, e.g. ["users:read","users:bulk"].
+// The literal scope "*" grants every permission.
+type Scopes []string
+
+// Has reports whether scopes contains scope or the wildcard "*".
+func (s Scopes) Has(scope string) bool {
+	for _, candidate := range s {
+		if candidate == "*" || candidate == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// Value implements driver.Valuer so gorm can persist Scopes as JSON.
+func (s Scopes) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan implements sql.Scanner so gorm can hydrate Scopes from JSON.
+func (s *Scopes) Scan(value interface{}) error {
+	bytes, ok := value.([]byte)
+	if !ok {
+		if str, ok := value.(string); ok {
+			bytes = []byte(str)
+		} else {
+			return errors.New("scopes: beklenmeyen veri tipi")
+		}
+	}
+	return json.Unmarshal(bytes, s)
+}
+
+// ApiKey is a personal access token a user can issue for headless/service
+// clients. Only HashedSecret (sha256 of the full presented secret) is kept
+// server-side; the plaintext secret is returned once, at issue time.
+type ApiKey struct {
+	ID           uint   `gorm:"primaryKey"`
+	UserID       uint   `gorm:"index;not null"`
+	Name         string `gorm:"size:150;not null"`
+	Prefix       string `gorm:"size:32;uniqueIndex;not null"`
+	HashedSecret string `gorm:"size:64;not null"`
+	Scopes       Scopes `gorm:"type:text"`
+	ExpiresAt    *time.Time
+	RevokedAt    *time.Time
+	CreatedAt    time.Time
+}
+
+// Revoked reports whether the key has been explicitly revoked.
+func (k *ApiKey) Revoked() bool {
+	return k.RevokedAt != nil
+}
+
+// Expired reports whether the key's TTL has elapsed.
+func (k *ApiKey) Expired() bool {
+	return k.ExpiresAt != nil && time.Now().After(*k.ExpiresAt)
+}
+
+// HasScope reports whether the key is authorized for scope.
+func (k *ApiKey) HasScope(scope string) bool {
+	return k.Scopes.Has(scope)
+}
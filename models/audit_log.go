@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+// AuditAction identifies which BaseService[T] write path produced an
+// AuditLog entry.
+type AuditAction string
+
+const (
+	AuditActionCreate     AuditAction = "create"
+	AuditActionUpdate     AuditAction = "update"
+	AuditActionDelete     AuditAction = "delete"
+	AuditActionBulkCreate AuditAction = "bulk_create"
+	AuditActionBulkUpdate AuditAction = "bulk_update"
+	AuditActionBulkDelete AuditAction = "bulk_delete"
+)
+
+// RedactedValue replaces any sensitive field (password hashes, secrets) in
+// a persisted diff.
+const RedactedValue = "***"
+
+// AuditLog is a tamper-evident record of one write against one entity.
+// ChangedFields holds only the fields that actually changed, each as
+// {"old": ..., "new": ...}.
+type AuditLog struct {
+	ID            uint        `gorm:"primaryKey"`
+	ActorID       uint        `gorm:"index;not null"`
+	EntityType    string      `gorm:"size:100;index;not null"`
+	EntityID      uint        `gorm:"index;not null"`
+	Action        AuditAction `gorm:"size:30;not null"`
+	ChangedFields datatypes.JSON
+	IP            string `gorm:"size:64"`
+	UserAgent     string `gorm:"size:255"`
+	OccurredAt    time.Time
+}
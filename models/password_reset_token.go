@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// PasswordResetToken is a single-use, time-bounded token issued by
+// RequestPasswordReset. Only its sha256 hash is stored; the plaintext
+// token is sent to the user and never persisted.
+type PasswordResetToken struct {
+	ID          uint   `gorm:"primaryKey"`
+	UserID      uint   `gorm:"index;not null"`
+	HashedToken string `gorm:"size:64;uniqueIndex;not null"`
+	ExpiresAt   time.Time
+	UsedAt      *time.Time
+	CreatedAt   time.Time
+}
+
+// Usable reports whether the token can still be redeemed.
+func (t *PasswordResetToken) Usable() bool {
+	return t.UsedAt == nil && time.Now().Before(t.ExpiresAt)
+}
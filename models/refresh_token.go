@@ -0,0 +1,20 @@
+package models
+
+import "time"
+
+// RefreshToken records a single refresh-token JTI so it can be revoked
+// (logout) or rotated (refresh). The plaintext refresh token itself is
+// never stored, only its JTI.
+type RefreshToken struct {
+	ID        uint   `gorm:"primaryKey"`
+	UserID    uint   `gorm:"index;not null"`
+	JTI       string `gorm:"size:36;uniqueIndex;not null"`
+	ExpiresAt time.Time
+	RevokedAt *time.Time
+	CreatedAt time.Time
+}
+
+// Revoked reports whether the token has already been logged out.
+func (t *RefreshToken) Revoked() bool {
+	return t.RevokedAt != nil
+}
@@ -0,0 +1,41 @@
+// Package models holds the gorm entities shared by the repository and
+// service layers.
+package models
+
+import (
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/gorm"
+)
+
+// User statuses.
+const (
+	UserStatusActive   = "active"
+	UserStatusInactive = "inactive"
+)
+
+// User is the core account entity. Password always holds a bcrypt hash,
+// never plaintext.
+type User struct {
+	gorm.Model
+	Name      string `gorm:"size:150;not null"`
+	Account   string `gorm:"size:150;uniqueIndex;not null"`
+	Password  string `gorm:"size:255;not null"`
+	Status    string `gorm:"size:30;not null;default:active"`
+	Type      string `gorm:"size:30;not null"`
+	UpdatedBy uint
+}
+
+// SetPassword hashes password with bcrypt and stores it on the user.
+func (u *User) SetPassword(password string) error {
+	hashed, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	u.Password = string(hashed)
+	return nil
+}
+
+// CheckPassword reports whether password matches the stored bcrypt hash.
+func (u *User) CheckPassword(password string) error {
+	return bcrypt.CompareHashAndPassword([]byte(u.Password), []byte(password))
+}
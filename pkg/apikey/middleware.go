@@ -0,0 +1,44 @@
+// Package apikey wires a verified *models.ApiKey into the request context
+// so services.IApiKeyService.RequireScope (via the service layer) can gate
+// bulk operations to keys that hold the right scope.
+package apikey
+
+import (
+	"context"
+	"strings"
+
+	"davet.link/services"
+	"github.com/gofiber/fiber/v2"
+)
+
+// contextUserIDKey mirrors services.contextUserIDKey so a key's owning user
+// still flows through to BaseService.Update's audit field.
+const contextUserIDKey = "user_id"
+
+// contextApiKeyKey mirrors the unexported key services.ApiKeyService reads
+// in RequireScope.
+const contextApiKeyKey = "api_key"
+
+// RequireAPIKey returns fiber middleware that verifies an `Authorization:
+// ApiKey <secret>` header and injects the resolved key and its owning
+// user_id into the request context.
+func RequireAPIKey(svc services.IApiKeyService) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		header := c.Get("Authorization")
+		presented, ok := strings.CutPrefix(header, "ApiKey ")
+		if !ok || presented == "" {
+			return fiber.NewError(fiber.StatusUnauthorized, "yetkilendirme başlığı eksik")
+		}
+
+		key, err := svc.Verify(c.Context(), presented)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+		}
+
+		ctx := context.WithValue(c.UserContext(), contextUserIDKey, key.UserID)
+		ctx = context.WithValue(ctx, contextApiKeyKey, key)
+		c.SetUserContext(ctx)
+		c.Locals(contextApiKeyKey, key)
+		return c.Next()
+	}
+}
@@ -0,0 +1,20 @@
+package auth
+
+import "github.com/golang-jwt/jwt/v5"
+
+// TokenType distinguishes access tokens from refresh tokens so a refresh
+// token can never be replayed as an access token and vice versa.
+type TokenType string
+
+const (
+	TokenTypeAccess  TokenType = "access"
+	TokenTypeRefresh TokenType = "refresh"
+)
+
+// Claims is the JWT payload minted for both access and refresh tokens. Type
+// is checked on parse so a refresh token presented as an access token (or
+// the reverse) is rejected.
+type Claims struct {
+	jwt.RegisteredClaims
+	Type TokenType `json:"typ"`
+}
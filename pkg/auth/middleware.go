@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// contextUserIDKey mirrors services.contextUserIDKey; it is duplicated here
+// (rather than imported) because services already depends on pkg/auth and
+// Go forbids the reverse import.
+const contextUserIDKey = "user_id"
+
+// RequireAuth returns fiber middleware that parses the bearer access token,
+// rejects the request on any failure, and injects the authenticated user_id
+// into the request's context.Context under the same key BaseService.Update
+// and BaseService.BulkUpdate already read.
+func RequireAuth(tm *TokenManager) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		header := c.Get("Authorization")
+		tokenString, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || tokenString == "" {
+			return fiber.NewError(fiber.StatusUnauthorized, "yetkilendirme başlığı eksik")
+		}
+
+		claims, err := tm.Parse(tokenString, TokenTypeAccess)
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+		}
+
+		userID, err := claims.UserID()
+		if err != nil {
+			return fiber.NewError(fiber.StatusUnauthorized, err.Error())
+		}
+
+		ctx := context.WithValue(c.UserContext(), contextUserIDKey, userID)
+		c.SetUserContext(ctx)
+		c.Locals(contextUserIDKey, userID)
+		return c.Next()
+	}
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"davet.link/configs/configsauth"
+)
+
+func testConfig() configsauth.Config {
+	return configsauth.Config{
+		Algorithm:       configsauth.AlgorithmHS256,
+		HMACSecret:      []byte("test-secret"),
+		AccessTokenTTL:  time.Minute,
+		RefreshTokenTTL: time.Hour,
+		Issuer:          "dlbase-test",
+	}
+}
+
+func TestTokenManagerMintParseRoundTrip(t *testing.T) {
+	configsauth.Init(testConfig())
+	tm := NewTokenManager()
+
+	minted, err := tm.Mint(42, TokenTypeAccess)
+	if err != nil {
+		t.Fatalf("Mint returned error: %v", err)
+	}
+
+	claims, err := tm.Parse(minted.Token, TokenTypeAccess)
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+
+	userID, err := claims.UserID()
+	if err != nil {
+		t.Fatalf("UserID returned error: %v", err)
+	}
+	if userID != 42 {
+		t.Errorf("UserID = %d, want 42", userID)
+	}
+}
+
+func TestTokenManagerParseRejectsTypeConfusion(t *testing.T) {
+	configsauth.Init(testConfig())
+	tm := NewTokenManager()
+
+	minted, err := tm.Mint(7, TokenTypeRefresh)
+	if err != nil {
+		t.Fatalf("Mint returned error: %v", err)
+	}
+
+	if _, err := tm.Parse(minted.Token, TokenTypeAccess); err != ErrInvalidToken {
+		t.Errorf("Parse(refresh token, want access) = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestTokenManagerParseRejectsBadSignature(t *testing.T) {
+	configsauth.Init(testConfig())
+	tm := NewTokenManager()
+
+	minted, err := tm.Mint(7, TokenTypeAccess)
+	if err != nil {
+		t.Fatalf("Mint returned error: %v", err)
+	}
+
+	configsauth.Init(configsauth.Config{
+		Algorithm:      configsauth.AlgorithmHS256,
+		HMACSecret:     []byte("different-secret"),
+		AccessTokenTTL: time.Minute,
+		Issuer:         "dlbase-test",
+	})
+	tmOther := NewTokenManager()
+
+	if _, err := tmOther.Parse(minted.Token, TokenTypeAccess); err != ErrInvalidToken {
+		t.Errorf("Parse(token signed with different secret) = %v, want ErrInvalidToken", err)
+	}
+}
@@ -0,0 +1,109 @@
+package password
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+)
+
+const (
+	lowerAlphabet  = "abcdefghijklmnopqrstuvwxyz"
+	upperAlphabet  = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitAlphabet  = "0123456789"
+	symbolAlphabet = "!@#$%^&*()-_=+"
+)
+
+// Generate returns a cryptographically random password of the given
+// length, containing exactly numDigits digits and numSymbols symbols (the
+// rest letters). noUpper excludes uppercase letters; allowRepeat permits
+// the same character to appear more than once. Suitable for admin-created
+// accounts that must also satisfy Policy.Validate.
+func Generate(length, numDigits, numSymbols int, noUpper, allowRepeat bool) (string, error) {
+	if length <= 0 || numDigits+numSymbols > length {
+		return "", errors.New("geçersiz şifre üretim parametreleri")
+	}
+
+	letters := lowerAlphabet
+	if !noUpper {
+		letters += upperAlphabet
+	}
+
+	numLetters := length - numDigits - numSymbols
+	pool := make([]rune, 0, length)
+	for i := 0; i < numLetters; i++ {
+		r, err := randomRune(letters, pool, allowRepeat)
+		if err != nil {
+			return "", err
+		}
+		pool = append(pool, r)
+	}
+	for i := 0; i < numDigits; i++ {
+		r, err := randomRune(digitAlphabet, pool, allowRepeat)
+		if err != nil {
+			return "", err
+		}
+		pool = append(pool, r)
+	}
+	for i := 0; i < numSymbols; i++ {
+		r, err := randomRune(symbolAlphabet, pool, allowRepeat)
+		if err != nil {
+			return "", err
+		}
+		pool = append(pool, r)
+	}
+
+	if err := shuffle(pool); err != nil {
+		return "", err
+	}
+	return string(pool), nil
+}
+
+// randomRune picks a random rune from alphabet. When allowRepeat is false
+// and alphabet is exhausted relative to already-picked runes of the same
+// class, it simply allows repeats rather than failing, since the caller
+// asked for an exact length.
+func randomRune(alphabet string, already []rune, allowRepeat bool) (rune, error) {
+	runes := []rune(alphabet)
+	for attempt := 0; attempt < 10; attempt++ {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(runes))))
+		if err != nil {
+			return 0, err
+		}
+		candidate := runes[n.Int64()]
+		if allowRepeat || !contains(already, candidate) {
+			return candidate, nil
+		}
+	}
+	for _, candidate := range runes {
+		if !contains(already, candidate) {
+			return candidate, nil
+		}
+	}
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(len(runes))))
+	if err != nil {
+		return 0, err
+	}
+	return runes[n.Int64()], nil
+}
+
+func contains(runes []rune, target rune) bool {
+	for _, r := range runes {
+		if r == target {
+			return true
+		}
+	}
+	return false
+}
+
+// shuffle performs an in-place Fisher-Yates shuffle using crypto/rand.
+func shuffle(runes []rune) error {
+	for i := len(runes) - 1; i > 0; i-- {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
+		if err != nil {
+			return err
+		}
+		j := n.Int64()
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Package password implements password strength policy enforcement and
+// secure generation, shared by every flow that sets a user's password
+// (admin create, self-service update, reset confirmation).
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Policy describes the minimum strength a password must meet. The zero
+// value enforces nothing; it accepts even an empty password.
+type Policy struct {
+	MinLen         int
+	RequireUpper   bool
+	RequireDigit   bool
+	RequireSymbol  bool
+	DisallowCommon []string
+	MaxRepeated    int // 0 disables the check
+	Breach         BreachChecker
+}
+
+// BreachChecker reports whether a password is known to be compromised (e.g.
+// via a Have-I-Been-Pwned-style k-anonymity lookup or a local bloom filter
+// of known-bad hashes). A nil BreachChecker skips the check.
+type BreachChecker interface {
+	IsBreached(password string) (bool, error)
+}
+
+// ViolationError aggregates every policy rule a password failed, so callers
+// can show the user all problems at once instead of one at a time.
+type ViolationError struct {
+	Violations []string
+}
+
+func (e *ViolationError) Error() string {
+	return fmt.Sprintf("şifre politikasına uygun değil: %s", strings.Join(e.Violations, "; "))
+}
+
+// Validate checks pw against the policy and returns a *ViolationError
+// listing every rule that failed, or nil if pw satisfies all of them. If the
+// BreachChecker itself fails, its wrapped error is returned instead.
+func (p Policy) Validate(pw string) error {
+	var violations []string
+
+	if p.MinLen > 0 && len(pw) < p.MinLen {
+		violations = append(violations, fmt.Sprintf("en az %d karakter olmalı", p.MinLen))
+	}
+	if p.RequireUpper && !strings.ContainsFunc(pw, unicode.IsUpper) {
+		violations = append(violations, "en az bir büyük harf içermeli")
+	}
+	if p.RequireDigit && !strings.ContainsFunc(pw, unicode.IsDigit) {
+		violations = append(violations, "en az bir rakam içermeli")
+	}
+	if p.RequireSymbol && !strings.ContainsFunc(pw, isSymbol) {
+		violations = append(violations, "en az bir özel karakter içermeli")
+	}
+	if p.MaxRepeated > 0 && hasRun(pw, p.MaxRepeated) {
+		violations = append(violations, fmt.Sprintf("aynı karakter %d defadan fazla art arda tekrar edemez", p.MaxRepeated))
+	}
+	for _, common := range p.DisallowCommon {
+		if strings.EqualFold(pw, common) {
+			violations = append(violations, "çok yaygın kullanılan bir şifre")
+			break
+		}
+	}
+	if p.Breach != nil {
+		breached, err := p.Breach.IsBreached(pw)
+		if err != nil {
+			return fmt.Errorf("şifre ihlal kontrolü başarısız: %w", err)
+		}
+		if breached {
+			violations = append(violations, "bilinen bir veri ihlalinde ele geçirilmiş")
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	return &ViolationError{Violations: violations}
+}
+
+func isSymbol(r rune) bool {
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.IsSpace(r)
+}
+
+// hasRun reports whether pw contains the same character repeated more than
+// maxRepeated times in a row.
+func hasRun(pw string, maxRepeated int) bool {
+	runes := []rune(pw)
+	run := 1
+	for i := 1; i < len(runes); i++ {
+		if runes[i] == runes[i-1] {
+			run++
+			if run > maxRepeated {
+				return true
+			}
+		} else {
+			run = 1
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package password
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	policy := Policy{
+		MinLen:        8,
+		RequireUpper:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MaxRepeated:   2,
+	}
+
+	tests := []struct {
+		name    string
+		pw      string
+		wantErr bool
+	}{
+		{"meets every rule", "Abcdef1!gh", false},
+		{"too short", "Ab1!", true},
+		{"missing upper", "abcdefg1!", true},
+		{"missing digit", "Abcdefg!h", true},
+		{"missing symbol", "Abcdefg1h", true},
+		{"too many repeats", "Aaaa1111!!!", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.pw)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate(%q) error = %v, wantErr %v", tt.pw, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestPolicyValidateDisallowsCommonPasswords(t *testing.T) {
+	policy := Policy{DisallowCommon: []string{"password123"}}
+
+	if err := policy.Validate("password123"); err == nil {
+		t.Error("Validate(common password) = nil, want error")
+	}
+	if err := policy.Validate("PASSWORD123"); err == nil {
+		t.Error("Validate(common password, different case) = nil, want error")
+	}
+}
+
+type fakeBreachChecker struct {
+	breached bool
+	err      error
+}
+
+func (f fakeBreachChecker) IsBreached(string) (bool, error) {
+	return f.breached, f.err
+}
+
+func TestPolicyValidateChecksBreach(t *testing.T) {
+	policy := Policy{Breach: fakeBreachChecker{breached: true}}
+
+	err := policy.Validate("anything")
+	if err == nil {
+		t.Fatal("Validate(breached password) = nil, want error")
+	}
+
+	var violationErr *ViolationError
+	if !errors.As(err, &violationErr) {
+		t.Fatalf("Validate error is %T, want *ViolationError", err)
+	}
+}
+
+func TestPolicyValidatePropagatesBreachCheckerError(t *testing.T) {
+	policy := Policy{Breach: fakeBreachChecker{err: errors.New("service down")}}
+
+	err := policy.Validate("anything")
+	if err == nil {
+		t.Fatal("Validate(breach checker error) = nil, want error")
+	}
+
+	var violationErr *ViolationError
+	if errors.As(err, &violationErr) {
+		t.Error("Validate should propagate the checker's own error, not a ViolationError")
+	}
+}
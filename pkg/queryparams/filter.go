@@ -0,0 +1,114 @@
+package queryparams
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// FilterOperator is the comparison a FilterOp applies.
+type FilterOperator string
+
+const (
+	FilterEq      FilterOperator = "eq"
+	FilterNeq     FilterOperator = "neq"
+	FilterLike    FilterOperator = "like"
+	FilterILike   FilterOperator = "ilike"
+	FilterIn      FilterOperator = "in"
+	FilterGt      FilterOperator = "gt"
+	FilterGte     FilterOperator = "gte"
+	FilterLt      FilterOperator = "lt"
+	FilterLte     FilterOperator = "lte"
+	FilterBetween FilterOperator = "between"
+	FilterNull    FilterOperator = "null"
+)
+
+// FilterOp is one parsed `filter[field]` or `filter[field][op]` query
+// parameter. Value holds a string for most operators, []string for in/
+// between, and a bool for null.
+type FilterOp struct {
+	Operator FilterOperator `json:"operator"`
+	Value    interface{}    `json:"value"`
+}
+
+// SortSpec is one comma-separated entry of the `sort` query parameter, e.g.
+// "-created_at" becomes {Field: "created_at", Dir: "desc"}.
+type SortSpec struct {
+	Field string `json:"field"`
+	Dir   string `json:"dir"`
+}
+
+// filterKeyPattern matches "filter[field]" and "filter[field][op]".
+var filterKeyPattern = regexp.MustCompile(`^filter\[([a-zA-Z0-9_]+)\](?:\[([a-zA-Z]+)\])?$`)
+
+// ParseFilters extracts every `filter[...]` parameter from query into a
+// field->FilterOp map. Unrecognized operators are rejected rather than
+// silently ignored, since they usually indicate a client typo.
+func ParseFilters(query url.Values) (map[string]FilterOp, error) {
+	filters := make(map[string]FilterOp)
+
+	for key, values := range query {
+		match := filterKeyPattern.FindStringSubmatch(key)
+		if match == nil {
+			continue
+		}
+		field, opName := match[1], match[2]
+		if opName == "" {
+			opName = string(FilterEq)
+		}
+		op := FilterOperator(opName)
+
+		raw := values[0]
+		value, err := parseFilterValue(op, raw)
+		if err != nil {
+			return nil, fmt.Errorf("filter[%s][%s]: %w", field, opName, err)
+		}
+
+		filters[field] = FilterOp{Operator: op, Value: value}
+	}
+
+	return filters, nil
+}
+
+func parseFilterValue(op FilterOperator, raw string) (interface{}, error) {
+	switch op {
+	case FilterEq, FilterNeq, FilterLike, FilterILike, FilterGt, FilterGte, FilterLt, FilterLte:
+		return raw, nil
+	case FilterIn:
+		return strings.Split(raw, ","), nil
+	case FilterBetween:
+		parts := strings.Split(raw, ",")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("between bir alt ve bir üst değer gerektirir (virgülle ayrılmış)")
+		}
+		return parts, nil
+	case FilterNull:
+		return raw == "true", nil
+	default:
+		return nil, fmt.Errorf("bilinmeyen filtre operatörü: %s", op)
+	}
+}
+
+// ParseSort parses the `sort` query parameter, e.g. "-created_at,name".
+// A leading "-" sorts that field descending.
+func ParseSort(raw string) []SortSpec {
+	if raw == "" {
+		return nil
+	}
+
+	var specs []SortSpec
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		dir := "asc"
+		if strings.HasPrefix(field, "-") {
+			dir = "desc"
+			field = field[1:]
+		}
+		specs = append(specs, SortSpec{Field: field, Dir: dir})
+	}
+	return specs
+}
@@ -0,0 +1,91 @@
+package queryparams
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestParseFiltersDefaultsToEq(t *testing.T) {
+	query := url.Values{"filter[status]": {"active"}}
+
+	filters, err := ParseFilters(query)
+	if err != nil {
+		t.Fatalf("ParseFilters returned error: %v", err)
+	}
+
+	want := FilterOp{Operator: FilterEq, Value: "active"}
+	if got := filters["status"]; got != want {
+		t.Errorf("filters[status] = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseFiltersOperators(t *testing.T) {
+	query := url.Values{
+		"filter[name][like]":     {"ali"},
+		"filter[type][in]":       {"a,b,c"},
+		"filter[age][between]":   {"18,30"},
+		"filter[deleted][null]":  {"true"},
+		"filter[unknown][bogus]": {"x"},
+	}
+
+	if _, err := ParseFilters(query); err == nil {
+		t.Fatal("ParseFilters with unknown operator = nil error, want error")
+	}
+
+	delete(query, "filter[unknown][bogus]")
+	filters, err := ParseFilters(query)
+	if err != nil {
+		t.Fatalf("ParseFilters returned error: %v", err)
+	}
+
+	if got := filters["name"]; got.Operator != FilterLike || got.Value != "ali" {
+		t.Errorf("filters[name] = %+v", got)
+	}
+	if got := filters["type"]; got.Operator != FilterIn || !reflect.DeepEqual(got.Value, []string{"a", "b", "c"}) {
+		t.Errorf("filters[type] = %+v", got)
+	}
+	if got := filters["age"]; got.Operator != FilterBetween || !reflect.DeepEqual(got.Value, []string{"18", "30"}) {
+		t.Errorf("filters[age] = %+v", got)
+	}
+	if got := filters["deleted"]; got.Operator != FilterNull || got.Value != true {
+		t.Errorf("filters[deleted] = %+v", got)
+	}
+}
+
+func TestParseFiltersBetweenRequiresTwoValues(t *testing.T) {
+	query := url.Values{"filter[age][between]": {"18"}}
+
+	if _, err := ParseFilters(query); err == nil {
+		t.Error("ParseFilters(between with one value) = nil error, want error")
+	}
+}
+
+func TestParseFiltersIgnoresUnrelatedKeys(t *testing.T) {
+	query := url.Values{"page": {"2"}, "per_page": {"20"}}
+
+	filters, err := ParseFilters(query)
+	if err != nil {
+		t.Fatalf("ParseFilters returned error: %v", err)
+	}
+	if len(filters) != 0 {
+		t.Errorf("filters = %+v, want empty", filters)
+	}
+}
+
+func TestParseSort(t *testing.T) {
+	got := ParseSort("-created_at,name")
+	want := []SortSpec{
+		{Field: "created_at", Dir: "desc"},
+		{Field: "name", Dir: "asc"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseSort = %+v, want %+v", got, want)
+	}
+}
+
+func TestParseSortEmpty(t *testing.T) {
+	if got := ParseSort(""); got != nil {
+		t.Errorf("ParseSort(\"\") = %+v, want nil", got)
+	}
+}
@@ -0,0 +1,75 @@
+// Package queryparams provides the pagination and filter parameters and
+// result envelopes shared by every IBaseService implementation.
+package queryparams
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// ListParams carries the pagination, filter, and sort request parsed from
+// a handler's query string.
+type ListParams struct {
+	Page    int
+	PerPage int
+	Filters map[string]FilterOp
+	Sort    []SortSpec
+}
+
+// PaginationMeta describes where a PaginatedResult sits relative to the
+// full result set, plus an echo of the filters/sort that produced it so
+// clients can render them (e.g. as chips) without re-deriving them from the
+// request.
+type PaginationMeta struct {
+	CurrentPage    int                 `json:"current_page"`
+	PerPage        int                 `json:"per_page"`
+	TotalItems     int64               `json:"total_items"`
+	TotalPages     int                 `json:"total_pages"`
+	AppliedFilters map[string]FilterOp `json:"applied_filters,omitempty"`
+	AppliedSort    []SortSpec          `json:"applied_sort,omitempty"`
+}
+
+// PaginatedResult is the envelope every GetAll-style method returns.
+type PaginatedResult struct {
+	Data interface{}    `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// CalculateTotalPages returns the number of pages needed to hold totalItems
+// at perPage items per page. A non-positive perPage yields zero pages rather
+// than dividing by zero.
+func CalculateTotalPages(totalItems int64, perPage int) int {
+	if perPage <= 0 {
+		return 0
+	}
+	pages := int(totalItems / int64(perPage))
+	if totalItems%int64(perPage) != 0 {
+		pages++
+	}
+	return pages
+}
+
+// Parse builds a ListParams from a handler's raw query string, e.g.
+// ?page=2&per_page=20&filter[status]=active&filter[name][like]=ali&sort=-created_at,name
+func Parse(query url.Values) (ListParams, error) {
+	page, _ := strconv.Atoi(query.Get("page"))
+	if page < 1 {
+		page = 1
+	}
+	perPage, _ := strconv.Atoi(query.Get("per_page"))
+	if perPage < 1 {
+		perPage = 20
+	}
+
+	filters, err := ParseFilters(query)
+	if err != nil {
+		return ListParams{}, err
+	}
+
+	return ListParams{
+		Page:    page,
+		PerPage: perPage,
+		Filters: filters,
+		Sort:    ParseSort(query.Get("sort")),
+	}, nil
+}
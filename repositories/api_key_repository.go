@@ -0,0 +1,62 @@
+package repositories
+
+import (
+	"context"
+
+	"davet.link/configs/configsdatabase"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+// IApiKeyRepository is the persistence contract for models.ApiKey.
+type IApiKeyRepository interface {
+	Create(ctx context.Context, key *models.ApiKey) error
+	GetByPrefix(prefix string) (*models.ApiKey, error)
+	GetByID(id uint) (*models.ApiKey, error)
+	Revoke(ctx context.Context, id uint) error
+	ListForUser(userID uint) ([]models.ApiKey, error)
+}
+
+type apiKeyRepository struct {
+	db *gorm.DB
+}
+
+// NewApiKeyRepository builds an IApiKeyRepository backed by the shared
+// database handle.
+func NewApiKeyRepository() IApiKeyRepository {
+	return &apiKeyRepository{db: configsdatabase.GetDB()}
+}
+
+func (r *apiKeyRepository) Create(ctx context.Context, key *models.ApiKey) error {
+	return r.db.WithContext(ctx).Create(key).Error
+}
+
+func (r *apiKeyRepository) GetByPrefix(prefix string) (*models.ApiKey, error) {
+	var key models.ApiKey
+	if err := r.db.Where("prefix = ?", prefix).First(&key).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *apiKeyRepository) GetByID(id uint) (*models.ApiKey, error) {
+	var key models.ApiKey
+	if err := r.db.First(&key, id).Error; err != nil {
+		return nil, err
+	}
+	return &key, nil
+}
+
+func (r *apiKeyRepository) Revoke(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&models.ApiKey{}).
+		Where("id = ?", id).
+		Update("revoked_at", gorm.Expr("now()")).Error
+}
+
+func (r *apiKeyRepository) ListForUser(userID uint) ([]models.ApiKey, error) {
+	var keys []models.ApiKey
+	err := r.db.Where("user_id = ?", userID).Order("created_at desc").Find(&keys).Error
+	return keys, err
+}
+
+var _ IApiKeyRepository = (*apiKeyRepository)(nil)
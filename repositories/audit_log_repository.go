@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+
+	"davet.link/configs/configsdatabase"
+	"davet.link/models"
+	"davet.link/pkg/queryparams"
+	"gorm.io/gorm"
+)
+
+// IAuditLogRepository is the persistence contract for models.AuditLog.
+// Create takes an explicit *gorm.DB so callers can persist the audit row in
+// the same transaction as the write it describes.
+type IAuditLogRepository interface {
+	Create(ctx context.Context, tx *gorm.DB, entry *models.AuditLog) error
+	ListForEntity(entityType string, entityID uint, params queryparams.ListParams) ([]models.AuditLog, int64, error)
+}
+
+type auditLogRepository struct {
+	db *gorm.DB
+}
+
+// NewAuditLogRepository builds an IAuditLogRepository backed by the shared
+// database handle.
+func NewAuditLogRepository() IAuditLogRepository {
+	return &auditLogRepository{db: configsdatabase.GetDB()}
+}
+
+func (r *auditLogRepository) Create(ctx context.Context, tx *gorm.DB, entry *models.AuditLog) error {
+	db := tx
+	if db == nil {
+		db = r.db
+	}
+	return db.WithContext(ctx).Create(entry).Error
+}
+
+func (r *auditLogRepository) ListForEntity(entityType string, entityID uint, params queryparams.ListParams) ([]models.AuditLog, int64, error) {
+	var logs []models.AuditLog
+	var total int64
+
+	query := r.db.Model(&models.AuditLog{}).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityID)
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (params.Page - 1) * params.PerPage
+	err := query.Order("occurred_at desc").Offset(offset).Limit(params.PerPage).Find(&logs).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	return logs, total, nil
+}
+
+var _ IAuditLogRepository = (*auditLogRepository)(nil)
@@ -0,0 +1,68 @@
+package repositories
+
+import (
+	"fmt"
+
+	"davet.link/pkg/queryparams"
+	"gorm.io/gorm"
+)
+
+// applyFilters translates params.Filters/params.Sort into parameterized
+// Gorm conditions, rejecting any field not present in whitelist so callers
+// can't filter or sort on arbitrary (or sensitive) columns via the query
+// string.
+func applyFilters(query *gorm.DB, params queryparams.ListParams, whitelist map[string]bool) (*gorm.DB, error) {
+	for field, op := range params.Filters {
+		if !whitelist[field] {
+			return nil, fmt.Errorf("filtrelenemeyen alan: %s", field)
+		}
+
+		switch op.Operator {
+		case queryparams.FilterEq:
+			query = query.Where(fmt.Sprintf("%s = ?", field), op.Value)
+		case queryparams.FilterNeq:
+			query = query.Where(fmt.Sprintf("%s <> ?", field), op.Value)
+		case queryparams.FilterLike:
+			query = query.Where(fmt.Sprintf("%s LIKE ?", field), "%"+fmt.Sprint(op.Value)+"%")
+		case queryparams.FilterILike:
+			query = query.Where(fmt.Sprintf("%s ILIKE ?", field), "%"+fmt.Sprint(op.Value)+"%")
+		case queryparams.FilterIn:
+			query = query.Where(fmt.Sprintf("%s IN ?", field), op.Value)
+		case queryparams.FilterGt:
+			query = query.Where(fmt.Sprintf("%s > ?", field), op.Value)
+		case queryparams.FilterGte:
+			query = query.Where(fmt.Sprintf("%s >= ?", field), op.Value)
+		case queryparams.FilterLt:
+			query = query.Where(fmt.Sprintf("%s < ?", field), op.Value)
+		case queryparams.FilterLte:
+			query = query.Where(fmt.Sprintf("%s <= ?", field), op.Value)
+		case queryparams.FilterBetween:
+			bounds, ok := op.Value.([]string)
+			if !ok || len(bounds) != 2 {
+				return nil, fmt.Errorf("between filtresi iki değer gerektirir: %s", field)
+			}
+			query = query.Where(fmt.Sprintf("%s BETWEEN ? AND ?", field), bounds[0], bounds[1])
+		case queryparams.FilterNull:
+			if isNull, _ := op.Value.(bool); isNull {
+				query = query.Where(fmt.Sprintf("%s IS NULL", field))
+			} else {
+				query = query.Where(fmt.Sprintf("%s IS NOT NULL", field))
+			}
+		default:
+			return nil, fmt.Errorf("bilinmeyen filtre operatörü: %s", op.Operator)
+		}
+	}
+
+	for _, sort := range params.Sort {
+		if !whitelist[sort.Field] {
+			return nil, fmt.Errorf("sıralanamayan alan: %s", sort.Field)
+		}
+		dir := "ASC"
+		if sort.Dir == "desc" {
+			dir = "DESC"
+		}
+		query = query.Order(fmt.Sprintf("%s %s", sort.Field, dir))
+	}
+
+	return query, nil
+}
@@ -0,0 +1,45 @@
+package repositories
+
+import (
+	"testing"
+
+	"davet.link/pkg/queryparams"
+	"gorm.io/gorm"
+)
+
+func TestApplyFiltersRejectsFieldNotInWhitelist(t *testing.T) {
+	whitelist := map[string]bool{"name": true}
+	params := queryparams.ListParams{
+		Filters: map[string]queryparams.FilterOp{
+			"password": {Operator: queryparams.FilterEq, Value: "whatever"},
+		},
+	}
+
+	if _, err := applyFilters((*gorm.DB)(nil), params, whitelist); err == nil {
+		t.Error("applyFilters(non-whitelisted filter field) = nil error, want error")
+	}
+}
+
+func TestApplyFiltersRejectsSortFieldNotInWhitelist(t *testing.T) {
+	whitelist := map[string]bool{"name": true}
+	params := queryparams.ListParams{
+		Sort: []queryparams.SortSpec{{Field: "password", Dir: "asc"}},
+	}
+
+	if _, err := applyFilters((*gorm.DB)(nil), params, whitelist); err == nil {
+		t.Error("applyFilters(non-whitelisted sort field) = nil error, want error")
+	}
+}
+
+func TestApplyFiltersRejectsUnknownOperator(t *testing.T) {
+	whitelist := map[string]bool{"status": true}
+	params := queryparams.ListParams{
+		Filters: map[string]queryparams.FilterOp{
+			"status": {Operator: "bogus", Value: "active"},
+		},
+	}
+
+	if _, err := applyFilters((*gorm.DB)(nil), params, whitelist); err == nil {
+		t.Error("applyFilters(unknown operator) = nil error, want error")
+	}
+}
@@ -0,0 +1,49 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"davet.link/configs/configsdatabase"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+// IPasswordResetTokenRepository is the persistence contract for
+// models.PasswordResetToken.
+type IPasswordResetTokenRepository interface {
+	Create(ctx context.Context, userID uint, hashedToken string, expiresAt time.Time) error
+	GetByHashedToken(hashedToken string) (*models.PasswordResetToken, error)
+	MarkUsed(ctx context.Context, id uint) error
+}
+
+type passwordResetTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewPasswordResetTokenRepository builds an IPasswordResetTokenRepository
+// backed by the shared database handle.
+func NewPasswordResetTokenRepository() IPasswordResetTokenRepository {
+	return &passwordResetTokenRepository{db: configsdatabase.GetDB()}
+}
+
+func (r *passwordResetTokenRepository) Create(ctx context.Context, userID uint, hashedToken string, expiresAt time.Time) error {
+	token := models.PasswordResetToken{UserID: userID, HashedToken: hashedToken, ExpiresAt: expiresAt}
+	return r.db.WithContext(ctx).Create(&token).Error
+}
+
+func (r *passwordResetTokenRepository) GetByHashedToken(hashedToken string) (*models.PasswordResetToken, error) {
+	var token models.PasswordResetToken
+	if err := r.db.Where("hashed_token = ?", hashedToken).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *passwordResetTokenRepository) MarkUsed(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Model(&models.PasswordResetToken{}).
+		Where("id = ?", id).
+		Update("used_at", time.Now()).Error
+}
+
+var _ IPasswordResetTokenRepository = (*passwordResetTokenRepository)(nil)
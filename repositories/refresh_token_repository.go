@@ -0,0 +1,56 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"davet.link/configs/configsdatabase"
+	"davet.link/models"
+	"gorm.io/gorm"
+)
+
+// IRefreshTokenRepository stores issued refresh-token JTIs so pkg/auth can
+// revoke them on logout and rotate them on refresh.
+type IRefreshTokenRepository interface {
+	Store(ctx context.Context, userID uint, jti string, expiresAt time.Time) error
+	GetByJTI(jti string) (*models.RefreshToken, error)
+	Revoke(ctx context.Context, jti string) error
+	RevokeAllForUser(ctx context.Context, userID uint) error
+}
+
+type refreshTokenRepository struct {
+	db *gorm.DB
+}
+
+// NewRefreshTokenRepository builds an IRefreshTokenRepository backed by the
+// shared database handle.
+func NewRefreshTokenRepository() IRefreshTokenRepository {
+	return &refreshTokenRepository{db: configsdatabase.GetDB()}
+}
+
+func (r *refreshTokenRepository) Store(ctx context.Context, userID uint, jti string, expiresAt time.Time) error {
+	token := models.RefreshToken{UserID: userID, JTI: jti, ExpiresAt: expiresAt}
+	return r.db.WithContext(ctx).Create(&token).Error
+}
+
+func (r *refreshTokenRepository) GetByJTI(jti string) (*models.RefreshToken, error) {
+	var token models.RefreshToken
+	if err := r.db.Where("jti = ?", jti).First(&token).Error; err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *refreshTokenRepository) Revoke(ctx context.Context, jti string) error {
+	return r.db.WithContext(ctx).Model(&models.RefreshToken{}).
+		Where("jti = ?", jti).
+		Update("revoked_at", time.Now()).Error
+}
+
+func (r *refreshTokenRepository) RevokeAllForUser(ctx context.Context, userID uint) error {
+	return r.db.WithContext(ctx).Model(&models.RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+}
+
+var _ IRefreshTokenRepository = (*refreshTokenRepository)(nil)
@@ -0,0 +1,130 @@
+// Package repositories implements the persistence layer consumed by
+// services via narrow, entity-specific interfaces.
+package repositories
+
+import (
+	"context"
+
+	"davet.link/configs/configsdatabase"
+	"davet.link/models"
+	"davet.link/pkg/queryparams"
+	"gorm.io/gorm"
+)
+
+// userFilterableColumns whitelists the columns GetAllUsers accepts in
+// filter[...]/sort query parameters, so callers can never filter or sort on
+// an arbitrary or sensitive column (e.g. password).
+var userFilterableColumns = map[string]bool{
+	"name":       true,
+	"account":    true,
+	"status":     true,
+	"type":       true,
+	"created_at": true,
+}
+
+// IUserRepository is the persistence contract for models.User.
+type IUserRepository interface {
+	GetAllUsers(params queryparams.ListParams) ([]models.User, int64, error)
+	GetUserByID(id uint) (*models.User, error)
+	GetUserByAccount(account string) (*models.User, error)
+	CreateUser(ctx context.Context, user *models.User) error
+	BulkCreateUsers(ctx context.Context, users []models.User) error
+	UpdateUser(ctx context.Context, id uint, data map[string]interface{}, updatedBy uint) error
+	BulkUpdateUsers(ctx context.Context, condition map[string]interface{}, data map[string]interface{}, updatedBy uint) error
+	DeleteUser(ctx context.Context, id uint) error
+	BulkDeleteUsers(ctx context.Context, condition map[string]interface{}) error
+	GetUserCount() (int64, error)
+
+	// Transaction runs fn against a single database transaction.
+	Transaction(ctx context.Context, fn func(tx *gorm.DB) error) error
+	// WithTx returns a repository bound to tx instead of the shared
+	// connection, for use inside a Transaction callback.
+	WithTx(tx *gorm.DB) IUserRepository
+}
+
+type userRepository struct {
+	db *gorm.DB
+}
+
+// NewUserRepository builds an IUserRepository backed by the shared database
+// handle.
+func NewUserRepository() IUserRepository {
+	return &userRepository{db: configsdatabase.GetDB()}
+}
+
+func (r *userRepository) GetAllUsers(params queryparams.ListParams) ([]models.User, int64, error) {
+	var users []models.User
+	var total int64
+
+	query, err := applyFilters(r.db.Model(&models.User{}), params, userFilterableColumns)
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	offset := (params.Page - 1) * params.PerPage
+	if err := query.Offset(offset).Limit(params.PerPage).Find(&users).Error; err != nil {
+		return nil, 0, err
+	}
+	return users, total, nil
+}
+
+func (r *userRepository) GetUserByID(id uint) (*models.User, error) {
+	var user models.User
+	if err := r.db.First(&user, id).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) GetUserByAccount(account string) (*models.User, error) {
+	var user models.User
+	if err := r.db.Where("account = ?", account).First(&user).Error; err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *userRepository) CreateUser(ctx context.Context, user *models.User) error {
+	return r.db.WithContext(ctx).Create(user).Error
+}
+
+func (r *userRepository) BulkCreateUsers(ctx context.Context, users []models.User) error {
+	return r.db.WithContext(ctx).Create(&users).Error
+}
+
+func (r *userRepository) UpdateUser(ctx context.Context, id uint, data map[string]interface{}, updatedBy uint) error {
+	data["updated_by"] = updatedBy
+	return r.db.WithContext(ctx).Model(&models.User{}).Where("id = ?", id).Updates(data).Error
+}
+
+func (r *userRepository) BulkUpdateUsers(ctx context.Context, condition map[string]interface{}, data map[string]interface{}, updatedBy uint) error {
+	data["updated_by"] = updatedBy
+	return r.db.WithContext(ctx).Model(&models.User{}).Where(condition).Updates(data).Error
+}
+
+func (r *userRepository) DeleteUser(ctx context.Context, id uint) error {
+	return r.db.WithContext(ctx).Delete(&models.User{}, id).Error
+}
+
+func (r *userRepository) BulkDeleteUsers(ctx context.Context, condition map[string]interface{}) error {
+	return r.db.WithContext(ctx).Where(condition).Delete(&models.User{}).Error
+}
+
+func (r *userRepository) GetUserCount() (int64, error) {
+	var count int64
+	err := r.db.Model(&models.User{}).Count(&count).Error
+	return count, err
+}
+
+func (r *userRepository) Transaction(ctx context.Context, fn func(tx *gorm.DB) error) error {
+	return r.db.WithContext(ctx).Transaction(fn)
+}
+
+func (r *userRepository) WithTx(tx *gorm.DB) IUserRepository {
+	return &userRepository{db: tx}
+}
+
+var _ IUserRepository = (*userRepository)(nil)
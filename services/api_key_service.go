@@ -0,0 +1,161 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"davet.link/models"
+	"davet.link/repositories"
+	"gorm.io/gorm"
+
+	"davet.link/configs/configslog"
+	"go.uber.org/zap"
+)
+
+// contextApiKeyKey is where RequireAPIKey middleware (pkg/apikey) stores the
+// verified *models.ApiKey for the duration of the request, so RequireScope
+// can check it.
+const contextApiKeyKey = "api_key"
+
+// Well-known scopes understood by the bulk user endpoints.
+const (
+	ScopeUsersRead  = "users:read"
+	ScopeUsersWrite = "users:write"
+	ScopeUsersBulk  = "users:bulk"
+	ScopeAll        = "*"
+)
+
+const apiKeyPrefixLen = 16
+
+// maxPrefixAttempts bounds retries when a freshly generated prefix collides
+// with an existing key. Each attempt draws fresh randomness from a 16-hex-
+// char (64-bit) space, so repeated collisions would indicate a broken RNG
+// rather than bad luck.
+const maxPrefixAttempts = 5
+
+type IApiKeyService interface {
+	IssueKey(ctx context.Context, userID uint, name string, scopes []string, ttl time.Duration) (string, *models.ApiKey, error)
+	Verify(ctx context.Context, presented string) (*models.ApiKey, error)
+	Revoke(ctx context.Context, id uint) error
+	ListForUser(ctx context.Context, userID uint) ([]models.ApiKey, error)
+	RequireScope(ctx context.Context, scope string) error
+}
+
+type ApiKeyService struct {
+	repo repositories.IApiKeyRepository
+}
+
+func NewApiKeyService() IApiKeyService {
+	return &ApiKeyService{repo: repositories.NewApiKeyRepository()}
+}
+
+// IssueKey generates a new secret, stores only its sha256 hash and a
+// display-safe prefix, and returns the plaintext secret exactly once. The
+// prefix is drawn from the same random secret, so on the rare occasion it
+// collides with an existing key's prefix, IssueKey just draws a fresh
+// secret rather than failing the request.
+func (s *ApiKeyService) IssueKey(ctx context.Context, userID uint, name string, scopes []string, ttl time.Duration) (string, *models.ApiKey, error) {
+	for attempt := 0; attempt < maxPrefixAttempts; attempt++ {
+		secret, err := randomSecret(32)
+		if err != nil {
+			configslog.Log.Error("Api key secret üretilemedi", zap.Error(err))
+			return "", nil, errors.New("anahtar oluşturulurken bir hata oluştu")
+		}
+		prefix := secret[:apiKeyPrefixLen]
+		plain := fmt.Sprintf("%s.%s", prefix, secret)
+
+		key := &models.ApiKey{
+			UserID:       userID,
+			Name:         name,
+			Prefix:       prefix,
+			HashedSecret: hashSecret(plain),
+			Scopes:       models.Scopes(scopes),
+		}
+		if ttl > 0 {
+			expiresAt := time.Now().Add(ttl)
+			key.ExpiresAt = &expiresAt
+		}
+
+		err = s.repo.Create(ctx, key)
+		if err == nil {
+			return plain, key, nil
+		}
+		if !errors.Is(err, gorm.ErrDuplicatedKey) {
+			configslog.Log.Error("Api key kaydedilemedi", zap.Error(err))
+			return "", nil, errors.New("anahtar oluşturulurken bir hata oluştu")
+		}
+		configslog.Log.Warn("Api key prefix çakışması, yeniden deneniyor", zap.Int("attempt", attempt+1))
+	}
+
+	return "", nil, errors.New("anahtar oluşturulurken bir hata oluştu")
+}
+
+// Verify looks up the key by its prefix and checks the presented secret's
+// hash, expiry, and revocation status.
+func (s *ApiKeyService) Verify(ctx context.Context, presented string) (*models.ApiKey, error) {
+	if len(presented) <= apiKeyPrefixLen+1 {
+		return nil, errors.New("geçersiz anahtar")
+	}
+	prefix := presented[:apiKeyPrefixLen]
+
+	key, err := s.repo.GetByPrefix(prefix)
+	if err != nil {
+		return nil, errors.New("geçersiz anahtar")
+	}
+	if key.Revoked() || key.Expired() {
+		return nil, errors.New("anahtarın süresi dolmuş veya iptal edilmiş")
+	}
+	if hashSecret(presented) != key.HashedSecret {
+		return nil, errors.New("geçersiz anahtar")
+	}
+	return key, nil
+}
+
+func (s *ApiKeyService) Revoke(ctx context.Context, id uint) error {
+	if err := s.repo.Revoke(ctx, id); err != nil {
+		return errors.New("anahtar iptal edilirken bir hata oluştu")
+	}
+	return nil
+}
+
+func (s *ApiKeyService) ListForUser(ctx context.Context, userID uint) ([]models.ApiKey, error) {
+	keys, err := s.repo.ListForUser(userID)
+	if err != nil {
+		return nil, errors.New("anahtarlar getirilirken bir hata oluştu")
+	}
+	return keys, nil
+}
+
+// RequireScope enforces scope against the *models.ApiKey stored in ctx by
+// the RequireAPIKey middleware. Requests that did not authenticate via an
+// API key (e.g. a user JWT) have nothing to check here and are let through.
+func (s *ApiKeyService) RequireScope(ctx context.Context, scope string) error {
+	key, ok := ctx.Value(contextApiKeyKey).(*models.ApiKey)
+	if !ok || key == nil {
+		return nil
+	}
+	if !key.HasScope(scope) {
+		return errors.New("bu işlem için gerekli yetkiye sahip değilsiniz")
+	}
+	return nil
+}
+
+func randomSecret(n int) (string, error) {
+	buf := make([]byte, n)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+var _ IApiKeyService = (*ApiKeyService)(nil)
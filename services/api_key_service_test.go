@@ -0,0 +1,147 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"davet.link/models"
+	"davet.link/repositories"
+)
+
+// fakeApiKeyRepository is a minimal in-memory stand-in for
+// repositories.IApiKeyRepository, keyed by prefix like the real table.
+type fakeApiKeyRepository struct {
+	byPrefix map[string]*models.ApiKey
+}
+
+func newFakeApiKeyRepository() *fakeApiKeyRepository {
+	return &fakeApiKeyRepository{byPrefix: make(map[string]*models.ApiKey)}
+}
+
+func (r *fakeApiKeyRepository) Create(_ context.Context, key *models.ApiKey) error {
+	if _, exists := r.byPrefix[key.Prefix]; exists {
+		return errors.New("prefix zaten kayıtlı")
+	}
+	r.byPrefix[key.Prefix] = key
+	return nil
+}
+
+func (r *fakeApiKeyRepository) GetByPrefix(prefix string) (*models.ApiKey, error) {
+	key, ok := r.byPrefix[prefix]
+	if !ok {
+		return nil, errors.New("anahtar bulunamadı")
+	}
+	return key, nil
+}
+
+func (r *fakeApiKeyRepository) GetByID(id uint) (*models.ApiKey, error) {
+	for _, key := range r.byPrefix {
+		if key.ID == id {
+			return key, nil
+		}
+	}
+	return nil, errors.New("anahtar bulunamadı")
+}
+
+func (r *fakeApiKeyRepository) Revoke(_ context.Context, id uint) error {
+	for _, key := range r.byPrefix {
+		if key.ID == id {
+			now := time.Now()
+			key.RevokedAt = &now
+			return nil
+		}
+	}
+	return errors.New("anahtar bulunamadı")
+}
+
+func (r *fakeApiKeyRepository) ListForUser(userID uint) ([]models.ApiKey, error) {
+	var keys []models.ApiKey
+	for _, key := range r.byPrefix {
+		if key.UserID == userID {
+			keys = append(keys, *key)
+		}
+	}
+	return keys, nil
+}
+
+var _ repositories.IApiKeyRepository = (*fakeApiKeyRepository)(nil)
+
+func TestApiKeyServiceIssueAndVerify(t *testing.T) {
+	repo := newFakeApiKeyRepository()
+	svc := &ApiKeyService{repo: repo}
+
+	plain, key, err := svc.IssueKey(context.Background(), 1, "ci", []string{"users:read"}, 0)
+	if err != nil {
+		t.Fatalf("IssueKey returned error: %v", err)
+	}
+
+	verified, err := svc.Verify(context.Background(), plain)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if verified.Prefix != key.Prefix {
+		t.Errorf("Verify returned prefix %q, want %q", verified.Prefix, key.Prefix)
+	}
+}
+
+func TestApiKeyServiceVerifyRejectsWrongSecret(t *testing.T) {
+	repo := newFakeApiKeyRepository()
+	svc := &ApiKeyService{repo: repo}
+
+	plain, _, err := svc.IssueKey(context.Background(), 1, "ci", nil, 0)
+	if err != nil {
+		t.Fatalf("IssueKey returned error: %v", err)
+	}
+
+	replacement := "0"
+	if plain[len(plain)-1] == '0' {
+		replacement = "1"
+	}
+	tampered := plain[:len(plain)-1] + replacement
+	if _, err := svc.Verify(context.Background(), tampered); err == nil {
+		t.Error("Verify(tampered secret) = nil error, want error")
+	}
+}
+
+func TestApiKeyServiceVerifyRejectsRevoked(t *testing.T) {
+	repo := newFakeApiKeyRepository()
+	svc := &ApiKeyService{repo: repo}
+
+	plain, key, err := svc.IssueKey(context.Background(), 1, "ci", nil, 0)
+	if err != nil {
+		t.Fatalf("IssueKey returned error: %v", err)
+	}
+	if err := svc.Revoke(context.Background(), key.ID); err != nil {
+		t.Fatalf("Revoke returned error: %v", err)
+	}
+
+	if _, err := svc.Verify(context.Background(), plain); err == nil {
+		t.Error("Verify(revoked key) = nil error, want error")
+	}
+}
+
+func TestApiKeyServiceVerifyRejectsExpired(t *testing.T) {
+	repo := newFakeApiKeyRepository()
+	svc := &ApiKeyService{repo: repo}
+
+	plain, _, err := svc.IssueKey(context.Background(), 1, "ci", nil, time.Nanosecond)
+	if err != nil {
+		t.Fatalf("IssueKey returned error: %v", err)
+	}
+	time.Sleep(time.Millisecond)
+
+	if _, err := svc.Verify(context.Background(), plain); err == nil {
+		t.Error("Verify(expired key) = nil error, want error")
+	}
+}
+
+func TestApiKeyServiceVerifyRejectsShortInput(t *testing.T) {
+	repo := newFakeApiKeyRepository()
+	svc := &ApiKeyService{repo: repo}
+
+	if _, err := svc.Verify(context.Background(), "short"); err == nil {
+		t.Error("Verify(too-short input) = nil error, want error")
+	}
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"time"
+
+	"davet.link/models"
+	"davet.link/pkg/queryparams"
+	"davet.link/repositories"
+	"gorm.io/gorm"
+
+	"davet.link/configs/configslog"
+	"go.uber.org/zap"
+)
+
+// sensitiveFields are never written to an audit diff in cleartext.
+var sensitiveFields = map[string]bool{
+	"password": true,
+}
+
+// AuditEntry describes one write for IAuditService.Record to diff and
+// persist.
+type AuditEntry struct {
+	ActorID    uint
+	EntityType string
+	EntityID   uint
+	Action     models.AuditAction
+	Before     map[string]interface{}
+	After      map[string]interface{}
+	IP         string
+	UserAgent  string
+}
+
+type IAuditService interface {
+	// Record diffs Before against After field-by-field and persists the
+	// result via tx, so it commits or rolls back with the write it
+	// describes. tx may be nil, in which case it is written standalone.
+	Record(ctx context.Context, tx *gorm.DB, entry AuditEntry) error
+	GetAuditTrail(entityType string, entityID uint, params queryparams.ListParams) (*queryparams.PaginatedResult, error)
+}
+
+type AuditService struct {
+	repo repositories.IAuditLogRepository
+}
+
+func NewAuditService() IAuditService {
+	return &AuditService{repo: repositories.NewAuditLogRepository()}
+}
+
+func (s *AuditService) Record(ctx context.Context, tx *gorm.DB, entry AuditEntry) error {
+	changed := diffFields(entry.Before, entry.After)
+	if len(changed) == 0 && entry.Action == models.AuditActionUpdate {
+		return nil
+	}
+
+	payload, err := json.Marshal(changed)
+	if err != nil {
+		configslog.Log.Error("Audit diff serileştirilemedi", zap.Error(err))
+		return errors.New("işlem kaydı oluşturulurken bir hata oluştu")
+	}
+
+	log := &models.AuditLog{
+		ActorID:       entry.ActorID,
+		EntityType:    entry.EntityType,
+		EntityID:      entry.EntityID,
+		Action:        entry.Action,
+		ChangedFields: payload,
+		IP:            entry.IP,
+		UserAgent:     entry.UserAgent,
+		OccurredAt:    time.Now(),
+	}
+
+	if err := s.repo.Create(ctx, tx, log); err != nil {
+		configslog.Log.Error("Audit kaydı yazılamadı", zap.Error(err))
+		return errors.New("işlem kaydı oluşturulurken bir hata oluştu")
+	}
+	return nil
+}
+
+func (s *AuditService) GetAuditTrail(entityType string, entityID uint, params queryparams.ListParams) (*queryparams.PaginatedResult, error) {
+	logs, total, err := s.repo.ListForEntity(entityType, entityID, params)
+	if err != nil {
+		configslog.Log.Error("Audit kayıtları alınamadı", zap.Error(err))
+		return nil, errors.New("işlem kayıtları getirilirken bir hata oluştu")
+	}
+
+	return &queryparams.PaginatedResult{
+		Data: logs,
+		Meta: queryparams.PaginationMeta{
+			CurrentPage: params.Page,
+			PerPage:     params.PerPage,
+			TotalItems:  total,
+			TotalPages:  queryparams.CalculateTotalPages(total, params.PerPage),
+		},
+	}, nil
+}
+
+// diffFields returns, for every key in after that is new or changed
+// relative to before, a {"old": ..., "new": ...} pair. Sensitive fields are
+// redacted on both sides.
+func diffFields(before, after map[string]interface{}) map[string]interface{} {
+	changed := make(map[string]interface{})
+	for field, newValue := range after {
+		oldValue := before[field]
+		if reflect.DeepEqual(oldValue, newValue) {
+			continue
+		}
+		if sensitiveFields[field] {
+			oldValue, newValue = models.RedactedValue, models.RedactedValue
+		}
+		changed[field] = map[string]interface{}{"old": oldValue, "new": newValue}
+	}
+	return changed
+}
+
+var _ IAuditService = (*AuditService)(nil)
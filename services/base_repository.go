@@ -0,0 +1,31 @@
+package services
+
+import (
+	"context"
+
+	"davet.link/pkg/queryparams"
+	"gorm.io/gorm"
+)
+
+// IBaseRepository is the persistence contract BaseService[T] drives. Every
+// entity-specific repository (IUserRepository and friends) mirrors this
+// shape with typed method names instead of embedding it directly.
+type IBaseRepository[T any] interface {
+	GetAll(params queryparams.ListParams) ([]T, int64, error)
+	GetByID(id uint) (*T, error)
+	Create(ctx context.Context, entity *T) error
+	BulkCreate(ctx context.Context, entities []T) error
+	Update(ctx context.Context, id uint, data map[string]interface{}, updatedBy uint) error
+	BulkUpdate(ctx context.Context, condition map[string]interface{}, data map[string]interface{}, updatedBy uint) error
+	Delete(ctx context.Context, id uint) error
+	BulkDelete(ctx context.Context, condition map[string]interface{}) error
+	GetCount() (int64, error)
+
+	// Transaction runs fn against a single database transaction, so the
+	// caller can pair a write with an audit record that commits or rolls
+	// back together with it.
+	Transaction(ctx context.Context, fn func(tx *gorm.DB) error) error
+	// WithTx returns a repository bound to tx instead of the shared
+	// connection, for use inside a Transaction callback.
+	WithTx(tx *gorm.DB) IBaseRepository[T]
+}
@@ -3,8 +3,14 @@ package services
 import (
 	"context"
 	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode"
 
+	"davet.link/models"
 	"davet.link/pkg/queryparams"
+	"gorm.io/gorm"
 
 	"davet.link/configs/configslog"
 	"go.uber.org/zap"
@@ -22,14 +28,27 @@ type IBaseService[T any] interface {
 	Delete(ctx context.Context, id uint) error
 	BulkDelete(ctx context.Context, condition map[string]interface{}) error
 	GetCount() (int64, error)
+	GetAuditTrail(entityID uint, params queryparams.ListParams) (*queryparams.PaginatedResult, error)
 }
 
 type BaseService[T any] struct {
-	repo IBaseRepository[T]
+	repo       IBaseRepository[T]
+	audit      IAuditService
+	entityType string
+	*HookRegistry[T]
 }
 
-func NewBaseService[T any](repo IBaseRepository[T]) *BaseService[T] {
-	return &BaseService[T]{repo: repo}
+// NewBaseService builds a BaseService[T]. entityType is the human-readable
+// name recorded on every audit_logs row written for this entity (e.g.
+// "User"). Side effects (welcome emails, cache invalidation, webhooks) hook
+// in via RegisterBefore/RegisterAfter rather than editing this type.
+func NewBaseService[T any](repo IBaseRepository[T], entityType string) *BaseService[T] {
+	return &BaseService[T]{
+		repo:         repo,
+		audit:        NewAuditService(),
+		entityType:   entityType,
+		HookRegistry: NewHookRegistry[T](),
+	}
 }
 
 func (s *BaseService[T]) GetAll(params queryparams.ListParams) (*queryparams.PaginatedResult, error) {
@@ -42,10 +61,12 @@ func (s *BaseService[T]) GetAll(params queryparams.ListParams) (*queryparams.Pag
 	result := &queryparams.PaginatedResult{
 		Data: entities,
 		Meta: queryparams.PaginationMeta{
-			CurrentPage: params.Page,
-			PerPage:     params.PerPage,
-			TotalItems:  totalCount,
-			TotalPages:  queryparams.CalculateTotalPages(totalCount, params.PerPage),
+			CurrentPage:    params.Page,
+			PerPage:        params.PerPage,
+			TotalItems:     totalCount,
+			TotalPages:     queryparams.CalculateTotalPages(totalCount, params.PerPage),
+			AppliedFilters: params.Filters,
+			AppliedSort:    params.Sort,
 		},
 	}
 	return result, nil
@@ -61,11 +82,35 @@ func (s *BaseService[T]) GetByID(id uint) (*T, error) {
 }
 
 func (s *BaseService[T]) Create(ctx context.Context, entity *T) error {
-	return s.repo.Create(ctx, entity)
+	ev := Event[T]{Action: ActionCreate, After: entity}
+
+	err := s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.runBefore(ctx, ev); err != nil {
+			return err
+		}
+		return s.repo.WithTx(tx).Create(ctx, entity)
+	})
+	if err != nil {
+		return err
+	}
+	s.runAfter(ctx, ev)
+	return nil
 }
 
 func (s *BaseService[T]) BulkCreate(ctx context.Context, entities []T) error {
-	return s.repo.BulkCreate(ctx, entities)
+	ev := Event[T]{Action: ActionBulkCreate, Data: map[string]interface{}{"count": len(entities)}}
+
+	err := s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.runBefore(ctx, ev); err != nil {
+			return err
+		}
+		return s.repo.WithTx(tx).BulkCreate(ctx, entities)
+	})
+	if err != nil {
+		return err
+	}
+	s.runAfter(ctx, ev)
+	return nil
 }
 
 func (s *BaseService[T]) Update(ctx context.Context, id uint, data map[string]interface{}) error {
@@ -74,12 +119,46 @@ func (s *BaseService[T]) Update(ctx context.Context, id uint, data map[string]in
 		return errors.New("güncelleyen kullanıcı kimliği geçersiz")
 	}
 
-	_, err := s.repo.GetByID(id)
+	before, err := s.repo.GetByID(id)
 	if err != nil {
 		return errors.New("kayıt bulunamadı")
 	}
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return err
+	}
+	ev := Event[T]{Action: ActionUpdate, Before: before, Data: data, ActorID: currentUserID}
+
+	// Snapshot data before it reaches txRepo.Update, which injects
+	// updated_by into the same map; the audit diff must only reflect
+	// caller-supplied fields.
+	auditAfter := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		auditAfter[k] = v
+	}
 
-	return s.repo.Update(ctx, id, data, currentUserID)
+	err = s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.runBefore(ctx, ev); err != nil {
+			return err
+		}
+		txRepo := s.repo.WithTx(tx)
+		if err := txRepo.Update(ctx, id, data, currentUserID); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    currentUserID,
+			EntityType: s.entityType,
+			EntityID:   id,
+			Action:     models.AuditActionUpdate,
+			Before:     beforeFields,
+			After:      auditAfter,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	s.runAfter(ctx, ev)
+	return nil
 }
 
 func (s *BaseService[T]) BulkUpdate(ctx context.Context, condition map[string]interface{}, data map[string]interface{}) error {
@@ -87,20 +166,178 @@ func (s *BaseService[T]) BulkUpdate(ctx context.Context, condition map[string]in
 	if !ok || currentUserID == 0 {
 		return errors.New("güncelleyen kullanıcı kimliği geçersiz")
 	}
+	ev := Event[T]{Action: ActionBulkUpdate, Data: data, ActorID: currentUserID}
 
-	return s.repo.BulkUpdate(ctx, condition, data, currentUserID)
+	err := s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.runBefore(ctx, ev); err != nil {
+			return err
+		}
+		txRepo := s.repo.WithTx(tx)
+		if err := txRepo.BulkUpdate(ctx, condition, data, currentUserID); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    currentUserID,
+			EntityType: s.entityType,
+			EntityID:   0,
+			Action:     models.AuditActionBulkUpdate,
+			Before:     map[string]interface{}{"condition": condition},
+			After:      data,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	s.runAfter(ctx, ev)
+	return nil
 }
 
 func (s *BaseService[T]) Delete(ctx context.Context, id uint) error {
-	return s.repo.Delete(ctx, id)
+	currentUserID, _ := ctx.Value(contextUserIDKey).(uint)
+
+	before, err := s.repo.GetByID(id)
+	if err != nil {
+		return errors.New("kayıt bulunamadı")
+	}
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return err
+	}
+	ev := Event[T]{Action: ActionDelete, Before: before, ActorID: currentUserID}
+
+	err = s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.runBefore(ctx, ev); err != nil {
+			return err
+		}
+		txRepo := s.repo.WithTx(tx)
+		if err := txRepo.Delete(ctx, id); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    currentUserID,
+			EntityType: s.entityType,
+			EntityID:   id,
+			Action:     models.AuditActionDelete,
+			Before:     beforeFields,
+			After:      map[string]interface{}{},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	s.runAfter(ctx, ev)
+	return nil
 }
 
 func (s *BaseService[T]) BulkDelete(ctx context.Context, condition map[string]interface{}) error {
-	return s.repo.BulkDelete(ctx, condition)
+	currentUserID, _ := ctx.Value(contextUserIDKey).(uint)
+	ev := Event[T]{Action: ActionBulkDelete, Data: map[string]interface{}{"condition": condition}, ActorID: currentUserID}
+
+	err := s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.runBefore(ctx, ev); err != nil {
+			return err
+		}
+		txRepo := s.repo.WithTx(tx)
+		if err := txRepo.BulkDelete(ctx, condition); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    currentUserID,
+			EntityType: s.entityType,
+			EntityID:   0,
+			Action:     models.AuditActionBulkDelete,
+			Before:     map[string]interface{}{"condition": condition},
+			After:      map[string]interface{}{},
+		})
+	})
+	if err != nil {
+		return err
+	}
+	s.runAfter(ctx, ev)
+	return nil
+}
+
+// toFields flattens entity's exported fields (including embedded structs
+// such as gorm.Model) into a map keyed by database column name, so it can
+// be diffed field-for-field against the map[string]interface{} update
+// payloads callers already pass (those are keyed by column name too, e.g.
+// "account", "status").
+func toFields(entity interface{}) (map[string]interface{}, error) {
+	v := reflect.ValueOf(entity)
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil, fmt.Errorf("kayıt serileştirilemedi: boş kayıt")
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("kayıt serileştirilemedi: struct bekleniyor")
+	}
+
+	fields := make(map[string]interface{})
+	collectFields(v, fields)
+	return fields, nil
+}
+
+// collectFields walks v's exported fields into fields, recursing into
+// anonymous (embedded) structs like gorm.Model instead of nesting them.
+func collectFields(v reflect.Value, fields map[string]interface{}) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		fv := v.Field(i)
+		if sf.Anonymous && fv.Kind() == reflect.Struct {
+			collectFields(fv, fields)
+			continue
+		}
+		fields[columnName(sf)] = fv.Interface()
+	}
+}
+
+// columnName derives the database column name gorm would use for sf,
+// honoring an explicit gorm:"column:..." tag and otherwise falling back to
+// gorm's own CamelCase-to-snake_case convention.
+func columnName(sf reflect.StructField) string {
+	for _, part := range strings.Split(sf.Tag.Get("gorm"), ";") {
+		if name, ok := strings.CutPrefix(part, "column:"); ok {
+			return name
+		}
+	}
+	return toSnakeCase(sf.Name)
+}
+
+// toSnakeCase mirrors gorm's CamelCase-to-snake_case convention: a run of
+// consecutive uppercase letters (an acronym like "ID" or "HTTP") is kept
+// together as one word, splitting only where it meets the next word, so
+// "UserID" becomes "user_id" rather than "user_i_d".
+func toSnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && !unicode.IsUpper(runes[i-1])
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if i > 0 && (prevLower || nextLower) {
+				b.WriteByte('_')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
 }
 
 func (s *BaseService[T]) GetCount() (int64, error) {
 	return s.repo.GetCount()
 }
 
+// GetAuditTrail returns the paginated change history recorded for entityID.
+func (s *BaseService[T]) GetAuditTrail(entityID uint, params queryparams.ListParams) (*queryparams.PaginatedResult, error) {
+	return s.audit.GetAuditTrail(s.entityType, entityID, params)
+}
+
 var _ IBaseService[any] = (*BaseService[any])(nil)
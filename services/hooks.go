@@ -0,0 +1,86 @@
+package services
+
+import (
+	"context"
+
+	"davet.link/configs/configslog"
+	"go.uber.org/zap"
+)
+
+// Action identifies which write path fired a lifecycle Event.
+type Action string
+
+const (
+	ActionCreate     Action = "create"
+	ActionUpdate     Action = "update"
+	ActionDelete     Action = "delete"
+	ActionBulkCreate Action = "bulk_create"
+	ActionBulkUpdate Action = "bulk_update"
+	ActionBulkDelete Action = "bulk_delete"
+)
+
+// Event is the payload passed to every registered Hook.
+type Event[T any] struct {
+	Action  Action
+	Before  *T
+	After   *T
+	Data    map[string]interface{}
+	ActorID uint
+}
+
+// Hook is a lifecycle callback a caller registers against an Action.
+type Hook[T any] func(ctx context.Context, ev Event[T]) error
+
+// HookRegistry is a generic Before/After hook registry, embedded by
+// BaseService[T] and reused directly by UserService so both get the same
+// registration API without UserService needing to route its hand-rolled
+// CRUD through BaseService[T].
+type HookRegistry[T any] struct {
+	before map[Action][]Hook[T]
+	after  map[Action][]Hook[T]
+}
+
+// NewHookRegistry builds an empty HookRegistry.
+func NewHookRegistry[T any]() *HookRegistry[T] {
+	return &HookRegistry[T]{
+		before: make(map[Action][]Hook[T]),
+		after:  make(map[Action][]Hook[T]),
+	}
+}
+
+// RegisterBefore runs hook synchronously, inside the same transaction as
+// the write; a returned error aborts the write.
+func (r *HookRegistry[T]) RegisterBefore(action Action, hook Hook[T]) {
+	r.before[action] = append(r.before[action], hook)
+}
+
+// RegisterAfter runs hook asynchronously after the write has committed;
+// errors are logged but never fail the request.
+func (r *HookRegistry[T]) RegisterAfter(action Action, hook Hook[T]) {
+	r.after[action] = append(r.after[action], hook)
+}
+
+func (r *HookRegistry[T]) runBefore(ctx context.Context, ev Event[T]) error {
+	for _, hook := range r.before[ev.Action] {
+		if err := hook(ctx, ev); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *HookRegistry[T]) runAfter(ctx context.Context, ev Event[T]) {
+	hooks := r.after[ev.Action]
+	if len(hooks) == 0 {
+		return
+	}
+	detached := context.WithoutCancel(ctx)
+	for _, hook := range hooks {
+		go func(h Hook[T]) {
+			if err := h(detached, ev); err != nil {
+				configslog.Log.Error("Lifecycle hook başarısız oldu",
+					zap.String("action", string(ev.Action)), zap.Error(err))
+			}
+		}(hook)
+	}
+}
@@ -0,0 +1,109 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"davet.link/models"
+	"davet.link/pkg/auth"
+
+	"davet.link/configs/configslog"
+	"go.uber.org/zap"
+)
+
+// LoginResult is returned by Login and Refresh. AccessToken is short-lived
+// and carries the caller's authorization; RefreshToken is long-lived and
+// single-use (Refresh rotates it).
+type LoginResult struct {
+	User             *models.User
+	AccessToken      string
+	RefreshToken     string
+	AccessExpiresAt  time.Time
+	RefreshExpiresAt time.Time
+}
+
+var errInvalidCredentials = errors.New("hesap veya şifre hatalı")
+
+// Login verifies account/password and mints a fresh access/refresh token
+// pair.
+func (s *UserService) Login(ctx context.Context, account, password string) (*LoginResult, error) {
+	user, err := s.repo.GetUserByAccount(account)
+	if err != nil {
+		return nil, errInvalidCredentials
+	}
+	if err := user.CheckPassword(password); err != nil {
+		return nil, errInvalidCredentials
+	}
+
+	return s.issueTokens(ctx, user)
+}
+
+// Logout revokes the refresh token identified by tokenID (its JTI) so
+// Refresh can no longer rotate it.
+func (s *UserService) Logout(ctx context.Context, tokenID string) error {
+	if err := s.refreshTokens.Revoke(ctx, tokenID); err != nil {
+		configslog.Log.Warn("Refresh token iptal edilemedi", zap.String("jti", tokenID), zap.Error(err))
+		return errors.New("oturum kapatılırken bir hata oluştu")
+	}
+	return nil
+}
+
+// Refresh validates a presented refresh token, revokes it, and mints a new
+// access/refresh pair (rotation).
+func (s *UserService) Refresh(ctx context.Context, refreshToken string) (*LoginResult, error) {
+	claims, err := s.tokens.Parse(refreshToken, auth.TokenTypeRefresh)
+	if err != nil {
+		return nil, err
+	}
+
+	stored, err := s.refreshTokens.GetByJTI(claims.ID)
+	if err != nil || stored.Revoked() {
+		return nil, auth.ErrInvalidToken
+	}
+
+	userID, err := claims.UserID()
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.repo.GetUserByID(userID)
+	if err != nil {
+		return nil, errors.New("kullanıcı bulunamadı")
+	}
+
+	if err := s.refreshTokens.Revoke(ctx, claims.ID); err != nil {
+		return nil, errors.New("oturum yenilenirken bir hata oluştu")
+	}
+
+	return s.issueTokens(ctx, user)
+}
+
+// Register creates a new user account with a password, identical to
+// CreateUserWithPassword but named to match the public auth surface.
+func (s *UserService) Register(ctx context.Context, user *models.User, password string) error {
+	return s.CreateUserWithPassword(ctx, user, password)
+}
+
+func (s *UserService) issueTokens(ctx context.Context, user *models.User) (*LoginResult, error) {
+	access, err := s.tokens.Mint(user.ID, auth.TokenTypeAccess)
+	if err != nil {
+		return nil, errors.New("token oluşturulamadı")
+	}
+	refresh, err := s.tokens.Mint(user.ID, auth.TokenTypeRefresh)
+	if err != nil {
+		return nil, errors.New("token oluşturulamadı")
+	}
+
+	if err := s.refreshTokens.Store(ctx, user.ID, refresh.JTI, refresh.ExpiresAt); err != nil {
+		configslog.Log.Error("Refresh token kaydedilemedi", zap.Error(err))
+		return nil, errors.New("oturum açılırken bir hata oluştu")
+	}
+
+	return &LoginResult{
+		User:             user,
+		AccessToken:      access.Token,
+		RefreshToken:     refresh.Token,
+		AccessExpiresAt:  access.ExpiresAt,
+		RefreshExpiresAt: refresh.ExpiresAt,
+	}, nil
+}
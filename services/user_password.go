@@ -0,0 +1,111 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"davet.link/configs/configspassword"
+	"davet.link/models"
+	"davet.link/pkg/password"
+	"gorm.io/gorm"
+
+	"davet.link/configs/configslog"
+	"go.uber.org/zap"
+)
+
+const passwordResetTokenTTL = time.Hour
+
+// GeneratePassword returns a random password satisfying policy, for use by
+// admin bulk-create flows that need to hand the caller a usable password
+// rather than prompt an end user for one.
+func (s *UserService) GeneratePassword(policy password.Policy) (string, error) {
+	minLen := policy.MinLen
+	if minLen < 12 {
+		minLen = 12
+	}
+
+	for attempt := 0; attempt < 5; attempt++ {
+		candidate, err := password.Generate(minLen, 2, 2, false, false)
+		if err != nil {
+			return "", errors.New("şifre üretilirken bir hata oluştu")
+		}
+		if policy.Validate(candidate) == nil {
+			return candidate, nil
+		}
+	}
+	return "", errors.New("politikaya uygun şifre üretilemedi")
+}
+
+// RequestPasswordReset issues a single-use, time-bounded reset token for
+// account and returns its plaintext value (the caller is responsible for
+// delivering it to the user, e.g. by email); only its hash is stored.
+func (s *UserService) RequestPasswordReset(ctx context.Context, account string) (string, error) {
+	user, err := s.repo.GetUserByAccount(account)
+	if err != nil {
+		return "", errors.New("kullanıcı bulunamadı")
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		configslog.Log.Error("Şifre sıfırlama tokenı üretilemedi", zap.Error(err))
+		return "", errors.New("şifre sıfırlama isteği oluşturulamadı")
+	}
+	token := hex.EncodeToString(raw)
+
+	if err := s.passwordResets.Create(ctx, user.ID, hashToken(token), time.Now().Add(passwordResetTokenTTL)); err != nil {
+		configslog.Log.Error("Şifre sıfırlama tokenı kaydedilemedi", zap.Error(err))
+		return "", errors.New("şifre sıfırlama isteği oluşturulamadı")
+	}
+
+	return token, nil
+}
+
+// ConfirmPasswordReset validates the presented reset token, enforces the
+// active password policy on newPassword, and rotates the user's password
+// hash.
+func (s *UserService) ConfirmPasswordReset(ctx context.Context, token, newPassword string) error {
+	stored, err := s.passwordResets.GetByHashedToken(hashToken(token))
+	if err != nil || !stored.Usable() {
+		return errors.New("geçersiz veya süresi dolmuş sıfırlama bağlantısı")
+	}
+
+	if err := configspassword.Get().Validate(newPassword); err != nil {
+		return err
+	}
+
+	hashed := models.User{}
+	if err := hashed.SetPassword(newPassword); err != nil {
+		return errors.New("şifre oluşturulurken hata oluştu")
+	}
+
+	user, err := s.repo.GetUserByID(stored.UserID)
+	if err != nil {
+		return errors.New("kullanıcı bulunamadı")
+	}
+
+	return s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.repo.WithTx(tx).UpdateUser(ctx, stored.UserID, map[string]interface{}{"password": hashed.Password}, stored.UserID); err != nil {
+			return err
+		}
+		if err := s.passwordResets.MarkUsed(ctx, stored.ID); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    stored.UserID,
+			EntityType: userEntityType,
+			EntityID:   stored.UserID,
+			Action:     models.AuditActionUpdate,
+			Before:     map[string]interface{}{"password": user.Password},
+			After:      map[string]interface{}{"password": hashed.Password},
+		})
+	})
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
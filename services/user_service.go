@@ -4,14 +4,22 @@ import (
 	"context"
 	"errors"
 
+	"davet.link/configs/configspassword"
 	"davet.link/models"
+	"davet.link/pkg/auth"
+	"davet.link/pkg/password"
 	"davet.link/pkg/queryparams"
 	"davet.link/repositories"
+	"gorm.io/gorm"
 
 	"davet.link/configs/configslog"
 	"go.uber.org/zap"
 )
 
+// userEntityType is the EntityType recorded on every audit_logs row written
+// for a User.
+const userEntityType = "User"
+
 type IUserService interface {
 	GetAllUsers(params queryparams.ListParams) (*queryparams.PaginatedResult, error)
 	GetUserByID(id uint) (*models.User, error)
@@ -24,16 +32,51 @@ type IUserService interface {
 	GetUserCount() (int64, error)
 	CreateUserWithPassword(ctx context.Context, user *models.User, password string) error
 	UpdateUserWithPassword(ctx context.Context, id uint, userData *models.User, newPassword string) error
+	GetAuditTrail(entityID uint, params queryparams.ListParams) (*queryparams.PaginatedResult, error)
+
+	Login(ctx context.Context, account, password string) (*LoginResult, error)
+	Logout(ctx context.Context, tokenID string) error
+	Refresh(ctx context.Context, refreshToken string) (*LoginResult, error)
+	Register(ctx context.Context, user *models.User, password string) error
+
+	GeneratePassword(policy password.Policy) (string, error)
+	RequestPasswordReset(ctx context.Context, account string) (string, error)
+	ConfirmPasswordReset(ctx context.Context, token, newPassword string) error
 }
 
 type UserService struct {
-	repo repositories.IUserRepository
+	repo           repositories.IUserRepository
+	refreshTokens  repositories.IRefreshTokenRepository
+	passwordResets repositories.IPasswordResetTokenRepository
+	tokens         *auth.TokenManager
+	apiKeys        IApiKeyService
+	audit          IAuditService
+	*HookRegistry[models.User]
 }
 
 func NewUserService() IUserService {
-	return &UserService{
-		repo: repositories.NewUserRepository(),
+	s := &UserService{
+		repo:           repositories.NewUserRepository(),
+		refreshTokens:  repositories.NewRefreshTokenRepository(),
+		passwordResets: repositories.NewPasswordResetTokenRepository(),
+		tokens:         auth.NewTokenManager(),
+		apiKeys:        NewApiKeyService(),
+		audit:          NewAuditService(),
+		HookRegistry:   NewHookRegistry[models.User](),
 	}
+
+	s.RegisterBefore(ActionCreate, func(ctx context.Context, ev Event[models.User]) error {
+		pw, _ := ev.Data["password"].(string)
+		if pw == "" {
+			return errors.New("şifre alanı boş olamaz")
+		}
+		if err := configspassword.Get().Validate(pw); err != nil {
+			return err
+		}
+		return ev.After.SetPassword(pw)
+	})
+
+	return s
 }
 
 func (s *UserService) GetAllUsers(params queryparams.ListParams) (*queryparams.PaginatedResult, error) {
@@ -46,10 +89,12 @@ func (s *UserService) GetAllUsers(params queryparams.ListParams) (*queryparams.P
 	return &queryparams.PaginatedResult{
 		Data: users,
 		Meta: queryparams.PaginationMeta{
-			CurrentPage: params.Page,
-			PerPage:     params.PerPage,
-			TotalItems:  totalCount,
-			TotalPages:  queryparams.CalculateTotalPages(totalCount, params.PerPage),
+			CurrentPage:    params.Page,
+			PerPage:        params.PerPage,
+			TotalItems:     totalCount,
+			TotalPages:     queryparams.CalculateTotalPages(totalCount, params.PerPage),
+			AppliedFilters: params.Filters,
+			AppliedSort:    params.Sort,
 		},
 	}, nil
 }
@@ -80,6 +125,11 @@ func (s *UserService) UpdateUser(ctx context.Context, id uint, userData *models.
 		return errors.New("güncelleyen kullanıcı kimliği geçersiz")
 	}
 
+	before, err := s.repo.GetUserByID(id)
+	if err != nil {
+		return errors.New("kullanıcı bulunamadı")
+	}
+
 	updateData := map[string]interface{}{
 		"name":    userData.Name,
 		"account": userData.Account,
@@ -87,7 +137,27 @@ func (s *UserService) UpdateUser(ctx context.Context, id uint, userData *models.
 		"type":    userData.Type,
 	}
 
-	return s.repo.UpdateUser(ctx, id, updateData, currentUserID)
+	// Snapshot updateData before it reaches repo.UpdateUser, which injects
+	// updated_by into the same map; the audit diff must only reflect
+	// caller-supplied fields.
+	auditAfter := make(map[string]interface{}, len(updateData))
+	for k, v := range updateData {
+		auditAfter[k] = v
+	}
+
+	return s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.repo.WithTx(tx).UpdateUser(ctx, id, updateData, currentUserID); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    currentUserID,
+			EntityType: userEntityType,
+			EntityID:   id,
+			Action:     models.AuditActionUpdate,
+			Before:     map[string]interface{}{"name": before.Name, "account": before.Account, "status": before.Status, "type": before.Type},
+			After:      auditAfter,
+		})
+	})
 }
 
 func (s *UserService) BulkUpdateUsers(ctx context.Context, condition map[string]interface{}, data map[string]interface{}) error {
@@ -95,31 +165,94 @@ func (s *UserService) BulkUpdateUsers(ctx context.Context, condition map[string]
 	if !ok || currentUserID == 0 {
 		return errors.New("güncelleyen kullanıcı kimliği geçersiz")
 	}
+	if err := s.apiKeys.RequireScope(ctx, ScopeUsersBulk); err != nil {
+		return err
+	}
 
-	return s.repo.BulkUpdateUsers(ctx, condition, data, currentUserID)
+	return s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.repo.WithTx(tx).BulkUpdateUsers(ctx, condition, data, currentUserID); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    currentUserID,
+			EntityType: userEntityType,
+			Action:     models.AuditActionBulkUpdate,
+			Before:     map[string]interface{}{"condition": condition},
+			After:      data,
+		})
+	})
 }
 
 func (s *UserService) DeleteUser(ctx context.Context, id uint) error {
-	return s.repo.DeleteUser(ctx, id)
+	currentUserID, _ := ctx.Value("user_id").(uint)
+
+	before, err := s.repo.GetUserByID(id)
+	if err != nil {
+		return errors.New("kullanıcı bulunamadı")
+	}
+
+	return s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.repo.WithTx(tx).DeleteUser(ctx, id); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    currentUserID,
+			EntityType: userEntityType,
+			EntityID:   id,
+			Action:     models.AuditActionDelete,
+			Before:     map[string]interface{}{"name": before.Name, "account": before.Account, "status": before.Status, "type": before.Type},
+			After:      map[string]interface{}{},
+		})
+	})
 }
 
 func (s *UserService) BulkDeleteUsers(ctx context.Context, condition map[string]interface{}) error {
-	return s.repo.BulkDeleteUsers(ctx, condition)
+	if err := s.apiKeys.RequireScope(ctx, ScopeUsersBulk); err != nil {
+		return err
+	}
+	currentUserID, _ := ctx.Value("user_id").(uint)
+
+	return s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.repo.WithTx(tx).BulkDeleteUsers(ctx, condition); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    currentUserID,
+			EntityType: userEntityType,
+			Action:     models.AuditActionBulkDelete,
+			Before:     map[string]interface{}{"condition": condition},
+			After:      map[string]interface{}{},
+		})
+	})
+}
+
+// GetAuditTrail returns the paginated change history recorded for the user
+// with entityID.
+func (s *UserService) GetAuditTrail(entityID uint, params queryparams.ListParams) (*queryparams.PaginatedResult, error) {
+	return s.audit.GetAuditTrail(userEntityType, entityID, params)
 }
 
 func (s *UserService) GetUserCount() (int64, error) {
 	return s.repo.GetUserCount()
 }
 
+// CreateUserWithPassword hashes password onto user and creates it. The
+// validation and hashing itself happens in the Before-Create hook
+// registered in NewUserService; this is just the entry point that carries
+// the plaintext password into the hook via Event.Data.
 func (s *UserService) CreateUserWithPassword(ctx context.Context, user *models.User, password string) error {
-	if password == "" {
-		return errors.New("şifre alanı boş olamaz")
-	}
-	if err := user.SetPassword(password); err != nil {
-		configslog.Log.Error("Şifre oluşturulamadı", zap.Error(err))
-		return errors.New("şifre oluşturulurken hata oluştu")
+	ev := Event[models.User]{Action: ActionCreate, After: user, Data: map[string]interface{}{"password": password}}
+	err := s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.runBefore(ctx, ev); err != nil {
+			return err
+		}
+		return s.repo.WithTx(tx).CreateUser(ctx, user)
+	})
+	if err != nil {
+		return err
 	}
-	return s.CreateUser(ctx, user)
+	s.runAfter(ctx, ev)
+	return nil
 }
 
 func (s *UserService) UpdateUserWithPassword(ctx context.Context, id uint, userData *models.User, newPassword string) error {
@@ -128,22 +261,49 @@ func (s *UserService) UpdateUserWithPassword(ctx context.Context, id uint, userD
 		return errors.New("güncelleyen kullanıcı kimliği geçersiz")
 	}
 
+	before, err := s.repo.GetUserByID(id)
+	if err != nil {
+		return errors.New("kullanıcı bulunamadı")
+	}
+
 	updateData := map[string]interface{}{
 		"name":    userData.Name,
 		"account": userData.Account,
 		"status":  userData.Status,
 		"type":    userData.Type,
 	}
+	beforeData := map[string]interface{}{"name": before.Name, "account": before.Account, "status": before.Status, "type": before.Type}
 
 	if newPassword != "" {
+		if err := configspassword.Get().Validate(newPassword); err != nil {
+			return err
+		}
 		hashed := models.User{}
 		if err := hashed.SetPassword(newPassword); err != nil {
 			return errors.New("şifre oluşturulurken hata oluştu")
 		}
 		updateData["password"] = hashed.Password
+		beforeData["password"] = before.Password
+	}
+
+	auditAfter := make(map[string]interface{}, len(updateData))
+	for k, v := range updateData {
+		auditAfter[k] = v
 	}
 
-	return s.repo.UpdateUser(ctx, id, updateData, currentUserID)
+	return s.repo.Transaction(ctx, func(tx *gorm.DB) error {
+		if err := s.repo.WithTx(tx).UpdateUser(ctx, id, updateData, currentUserID); err != nil {
+			return err
+		}
+		return s.audit.Record(ctx, tx, AuditEntry{
+			ActorID:    currentUserID,
+			EntityType: userEntityType,
+			EntityID:   id,
+			Action:     models.AuditActionUpdate,
+			Before:     beforeData,
+			After:      auditAfter,
+		})
+	})
 }
 
 var _ IUserService = (*UserService)(nil)